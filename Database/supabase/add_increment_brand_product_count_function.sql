@@ -0,0 +1,20 @@
+-- Add increment_brand_product_count RPC used by the approval routes
+-- src/app/api/admin/submission-action/route.ts and
+-- src/app/api/admin/products/[id]/approve/route.ts call
+-- supabase.rpc("increment_brand_product_count", { brand_id }) after approving a product
+
+-- The parameter must be named brand_id to match the RPC's named argument
+CREATE OR REPLACE FUNCTION public.increment_brand_product_count(brand_id INTEGER)
+RETURNS VOID
+LANGUAGE sql AS $$
+    UPDATE public.brands
+    SET product_count = COALESCE(product_count, 0) + 1
+    WHERE id = increment_brand_product_count.brand_id;
+$$;
+
+-- Only signed-in callers (the admin routes) and the service role may call it
+REVOKE EXECUTE ON FUNCTION public.increment_brand_product_count(INTEGER) FROM PUBLIC, anon;
+GRANT EXECUTE ON FUNCTION public.increment_brand_product_count(INTEGER) TO authenticated, service_role;
+
+-- Add comments for documentation
+COMMENT ON FUNCTION public.increment_brand_product_count(INTEGER) IS 'Increment brands.product_count after a product is approved';
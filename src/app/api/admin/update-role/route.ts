@@ -1,29 +1,62 @@
 import { createClient } from '@/lib/database/supabase/server';
+import { supabase as serviceClient } from '@/lib/supabase';
 import { NextRequest, NextResponse } from 'next/server';
 
+const VALID_ROLES = ['newcomer', 'contributor', 'trusted_editor', 'moderator', 'admin', 'owner'];
+
+/**
+ * POST /api/admin/update-role - Change another user's role (owner only)
+ *
+ * Body: { userId, role }
+ *
+ * The caller's role is read from public.users, never from the request.
+ * Owners cannot change their own row, which also guarantees the last
+ * owner can never be demoted (the caller is always a remaining owner).
+ * The write uses the service client because RLS only lets users update
+ * their own row; authorization is enforced above it.
+ */
 export async function POST(request: NextRequest) {
   try {
     const supabase = await createClient();
-    
+
     // Get the current user
     const { data: { user }, error: authError } = await supabase.auth.getUser();
-    
+
     if (authError || !user) {
       return NextResponse.json({ error: 'Not authenticated' }, { status: 401 });
     }
 
     // Get the request body
-    const { role } = await request.json();
+    const { userId, role } = await request.json();
+
+    if (!userId || typeof userId !== 'string') {
+      return NextResponse.json({ error: 'userId is required' }, { status: 400 });
+    }
 
-    if (!role || !['newcomer', 'contributor', 'trusted_editor', 'moderator', 'admin', 'owner'].includes(role)) {
+    if (!role || !VALID_ROLES.includes(role)) {
       return NextResponse.json({ error: 'Invalid role' }, { status: 400 });
     }
 
-    // Update the user role in the public.users table
-    const { data, error } = await supabase
+    // Only owners may change roles
+    const { data: caller, error: callerError } = await supabase
       .from('users')
-      .update({ role })
+      .select('role')
       .eq('id', user.id)
+      .single();
+
+    if (callerError || !caller || caller.role !== 'owner') {
+      return NextResponse.json({ error: 'Owner role required' }, { status: 403 });
+    }
+
+    if (userId === user.id) {
+      return NextResponse.json({ error: 'You cannot change your own role' }, { status: 403 });
+    }
+
+    // Update the target user's role in the public.users table
+    const { data, error } = await serviceClient
+      .from('users')
+      .update({ role })
+      .eq('id', userId)
       .select();
 
     if (error) {
@@ -31,8 +64,12 @@ export async function POST(request: NextRequest) {
       return NextResponse.json({ error: 'Failed to update role' }, { status: 500 });
     }
 
-    return NextResponse.json({ 
-      success: true, 
+    if (!data || data.length === 0) {
+      return NextResponse.json({ error: 'User not found' }, { status: 404 });
+    }
+
+    return NextResponse.json({
+      success: true,
       message: `Role updated to ${role}`,
       user: data[0]
     });
@@ -1,4 +1,5 @@
 import { supabase } from "@/lib/supabase";
+import { generateId, generateSlug, withSlugSuffix } from "@/lib/utils/helpers";
 import { sanitizeHttpUrl } from "@/lib/utils/url-sanitizer";
 import { NextRequest, NextResponse } from "next/server";
 import { z } from "zod";
@@ -66,8 +67,8 @@ const ProductApprovalRequestSchema = z.object({
   reviewed_by: z.string().uuid(),
 });
 
-// Helper function to generate slug
-function generateSlug(
+// Helper function to generate a product slug from brand, name and year
+function buildProductSlug(
   brandName: string,
   productName: string,
   year?: string,
@@ -76,10 +77,40 @@ function generateSlug(
   if (year) {
     combined += ` ${year}`;
   }
-  return combined
-    .toLowerCase()
-    .replace(/[^a-z0-9]+/g, "-")
-    .replace(/(^-|-$)/g, "");
+  return generateSlug(combined) || randomSlug("product");
+}
+
+// Fallback for names with nothing to transliterate (e.g. "蛋白粉")
+function randomSlug(prefix: string): string {
+  return `${prefix}-${generateId(6).toLowerCase()}`;
+}
+
+const MAX_SLUG_ATTEMPTS = 5;
+const UNIQUE_VIOLATION = "23505";
+
+// Slug to retry with: base-2 ... base-4, then a random suffix
+function slugCandidate(baseSlug: string, attempt: number): string {
+  if (attempt < MAX_SLUG_ATTEMPTS - 1) {
+    return withSlugSuffix(baseSlug, attempt + 1);
+  }
+  return withSlugSuffix(baseSlug, generateId(6).toLowerCase());
+}
+
+// Helper function to run an insert, retrying with a suffixed slug when the
+// slug collides with an existing row's unique slug
+async function insertWithUniqueSlug<R extends { error: { code: string } | null }>(
+  baseSlug: string,
+  insert: (slug: string) => PromiseLike<R>,
+): Promise<R> {
+  let result = await insert(baseSlug);
+  for (
+    let attempt = 1;
+    attempt < MAX_SLUG_ATTEMPTS && result.error?.code === UNIQUE_VIOLATION;
+    attempt++
+  ) {
+    result = await insert(slugCandidate(baseSlug, attempt));
+  }
+  return result;
 }
 
 // Helper function to parse year
@@ -164,17 +195,16 @@ export async function POST(request: NextRequest) {
     let brandId: number;
     if (brandError || !brandData) {
       // Create new brand
-      const { data: newBrand, error: createBrandError } = await supabase
-        .from("brands")
-        .insert({
-          name: validatedData.brand_name,
-          slug: validatedData.brand_name
-            .toLowerCase()
-            .replace(/[^a-z0-9]+/g, "-")
-            .replace(/(^-|-$)/g, ""),
-        })
-        .select("id")
-        .single();
+      const { data: newBrand, error: createBrandError } =
+        await insertWithUniqueSlug(
+          generateSlug(validatedData.brand_name) || randomSlug("brand"),
+          (slug) =>
+            supabase
+              .from("brands")
+              .insert({ name: validatedData.brand_name, slug })
+              .select("id")
+              .single(),
+        );
 
       if (createBrandError || !newBrand) {
         return NextResponse.json(
@@ -188,40 +218,45 @@ export async function POST(request: NextRequest) {
     }
 
     // Insert pending product
-    const { data: pendingProduct, error: insertError } = await supabase
-      .from("pending_products")
-      .insert({
-        brand_id: brandId,
-        category: validatedData.category,
-        product_name: validatedData.name,
-        slug: generateSlug(
+    const { data: pendingProduct, error: insertError } =
+      await insertWithUniqueSlug(
+        buildProductSlug(
           validatedData.brand_name,
           validatedData.name,
           validatedData.year,
         ),
-        image_url: safeImageUrl,
-        description: validatedData.description,
-        price: validatedData.price,
-        currency: "USD",
-        servings_per_container: validatedData.servings_per_container,
-        serving_size_g: validatedData.serving_size_g,
-        dosage_rating: 0,
-        danger_rating: 0,
-        approval_status: 0, // 0 = pending
-        submitted_by: validatedData.submitted_by,
-      })
-      .select(
-        `
-        *,
-        brands:brand_id (
-          id,
-          name,
-          slug,
-          website
-        )
-      `,
-      )
-      .single();
+        (slug) =>
+          supabase
+            .from("pending_products")
+            .insert({
+              brand_id: brandId,
+              category: validatedData.category,
+              product_name: validatedData.name,
+              slug,
+              image_url: safeImageUrl,
+              description: validatedData.description,
+              price: validatedData.price,
+              currency: "USD",
+              servings_per_container: validatedData.servings_per_container,
+              serving_size_g: validatedData.serving_size_g,
+              dosage_rating: 0,
+              danger_rating: 0,
+              approval_status: 0, // 0 = pending
+              submitted_by: validatedData.submitted_by,
+            })
+            .select(
+              `
+              *,
+              brands:brand_id (
+                id,
+                name,
+                slug,
+                website
+              )
+            `,
+            )
+            .single(),
+      );
 
     if (insertError) {
       return NextResponse.json(
@@ -22,6 +22,7 @@ interface RejectSubmissionParams {
 }
 
 interface UpdateRoleParams {
+  userId: string;
   role: string;
 }
 
@@ -15,7 +15,7 @@ export function useAdminActions() {
 
     setIsProcessing(true);
     try {
-      const result = await adminService.updateUserRole({ role: targetRole });
+      const result = await adminService.updateUserRole({ userId, role: targetRole });
       
       if (!result.success) {
         throw new Error(result.error || 'Failed to update role');
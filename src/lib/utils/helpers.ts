@@ -39,16 +39,54 @@ export function formatLargeNumber(num: number): string {
   return num.toString();
 }
 
+// Letters that NFKD normalization does not decompose into ASCII
+const SLUG_TRANSLITERATIONS: Record<string, string> = {
+  ß: 'ss',
+  æ: 'ae',
+  œ: 'oe',
+  ø: 'o',
+  đ: 'd',
+  ð: 'd',
+  ł: 'l',
+  þ: 'th',
+  µ: 'u', // Micro sign, so 100µg becomes 100ug
+  μ: 'u', // Greek mu, which NFKD produces from the micro sign
+};
+
+const SLUG_TRANSLITERATION_PATTERN = new RegExp(
+  `[${Object.keys(SLUG_TRANSLITERATIONS).join('')}]`,
+  'g'
+);
+
 /**
- * Generate a slug from a string
+ * Generate a URL-safe slug from a string
+ * Transliterates accented characters and keeps only [a-z0-9-]
  */
-export function generateSlug(text: string): string {
+export function generateSlug(text: string, maxLength = 100): string {
   return text
+    .replace(/[©®™]/g, '') // Remove ©, ® and ™ marks
+    .normalize('NFKD')
+    .replace(/[\u0300-\u036f]/g, '') // Remove accents left by decomposition
     .toLowerCase()
-    .replace(/[^\w\s-]/g, '') // Remove special characters
-    .replace(/\s+/g, '-') // Replace spaces with hyphens
-    .replace(/-+/g, '-') // Replace multiple hyphens with single
-    .trim();
+    .replace(SLUG_TRANSLITERATION_PATTERN, char => SLUG_TRANSLITERATIONS[char])
+    .replace(/[^a-z0-9]+/g, '-') // Replace everything else with hyphens
+    .replace(/^-+|-+$/g, '') // Trim leading/trailing hyphens
+    .slice(0, maxLength)
+    .replace(/-+$/, ''); // Avoid a trailing hyphen after truncation
+}
+
+/**
+ * Append a uniqueness suffix to a slug
+ * Trims the base so the suffixed slug still fits within maxLength
+ */
+export function withSlugSuffix(
+  slug: string,
+  suffix: number | string,
+  maxLength = 100
+): string {
+  const tail = `-${suffix}`;
+  const base = slug.slice(0, maxLength - tail.length).replace(/-+$/, '');
+  return base ? base + tail : String(suffix);
 }
 
 /**
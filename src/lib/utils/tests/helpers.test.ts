@@ -0,0 +1,61 @@
+import { describe, expect, it } from "vitest";
+import { generateSlug, withSlugSuffix } from "../helpers";
+
+describe("generateSlug", () => {
+  it.each([
+    ["Gold Standard 100% Whey", "gold-standard-100-whey"],
+    ["C4® Pre-Workout", "c4-pre-workout"],
+    ["Ghost™ Legend", "ghost-legend"],
+    ["Nestlé Health Science", "nestle-health-science"],
+    ["Crème Brûlée", "creme-brulee"],
+    ["Müller Straße", "muller-strasse"],
+    ["Øresund Ærø Łódź", "oresund-aero-lodz"],
+    ["Protein, Whey / Casein", "protein-whey-casein"],
+    ["  --Jacked3d--  ", "jacked3d"],
+    ["N.O.-Xplode", "n-o-xplode"],
+    ["Rocky & Co. 2024", "rocky-co-2024"],
+    ["Huperzine A 100µg", "huperzine-a-100ug"],
+    ["Vitamin B12 500μg", "vitamin-b12-500ug"],
+    ["®™©", ""],
+    ["蛋白粉", ""],
+  ])("slugifies %j as %j", (input, expected) => {
+    expect(generateSlug(input)).toBe(expected);
+  });
+
+  it("only ever emits [a-z0-9-]", () => {
+    const slug = generateSlug("Ünïcödé — “quoted” (test) ½ scoop");
+    expect(slug).toMatch(/^[a-z0-9]+(-[a-z0-9]+)*$/);
+  });
+
+  it("caps length without leaving a trailing hyphen", () => {
+    expect(generateSlug("abc def ghi", 4)).toBe("abc");
+    expect(generateSlug("a".repeat(150))).toHaveLength(100);
+  });
+});
+
+describe("withSlugSuffix", () => {
+  it("appends the suffix to short slugs", () => {
+    expect(withSlugSuffix("c4-pre-workout", 2)).toBe("c4-pre-workout-2");
+    expect(withSlugSuffix("c4-pre-workout", "x7k2qp")).toBe("c4-pre-workout-x7k2qp");
+  });
+
+  it("keeps capped slugs that share a prefix distinct and within the cap", () => {
+    const first = generateSlug(`${"x".repeat(120)} Vanilla`);
+    const second = generateSlug(`${"x".repeat(120)} Chocolate`);
+    expect(first).toBe(second);
+    expect(first).toHaveLength(100);
+
+    const suffixed = withSlugSuffix(second, 2);
+    expect(suffixed).not.toBe(first);
+    expect(suffixed).toHaveLength(100);
+    expect(suffixed.endsWith("-2")).toBe(true);
+  });
+
+  it("does not leave a double hyphen when trimming lands on one", () => {
+    expect(withSlugSuffix("abc-def", 2, 6)).toBe("abc-2");
+  });
+
+  it("falls back to the bare suffix for an empty slug", () => {
+    expect(withSlugSuffix("", 3)).toBe("3");
+  });
+});